@@ -0,0 +1,64 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IPPool is the Schema for the IPPools API.
+//
+// This checkout only carries the part of the real spec the LoadBalancer controller needs
+// (CIDR, AllowedUses); the other IPPool fields (BlockSize, NATOutgoing, IPIPMode, VXLANMode,
+// NodeSelector, Disabled, ...) aren't modeled here.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type IPPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec IPPoolSpec `json:"spec,omitempty"`
+}
+
+// IPPoolList contains a list of IPPool resources.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type IPPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IPPool `json:"items"`
+}
+
+// IPPoolSpec contains the specification for an IPPool resource.
+type IPPoolSpec struct {
+	// CIDR contains the address range for the IPPool in classless inter-domain routing format.
+	CIDR string `json:"cidr"`
+
+	// AllowedUses controls what the pool will be used for. If not specified or empty, defaults
+	// to ["Tunnel", "Workload"] for back-compatibility.
+	AllowedUses []IPPoolAllowedUse `json:"allowedUses,omitempty" validate:"omitempty,dive,oneof=Workload Tunnel Reserved LoadBalancer"`
+}
+
+// IPPoolAllowedUse names one thing an IPPool's addresses may be used for.
+type IPPoolAllowedUse string
+
+const (
+	// IPPoolAllowedUseWorkload allows the pool to be used for workload endpoints.
+	IPPoolAllowedUseWorkload IPPoolAllowedUse = "Workload"
+	// IPPoolAllowedUseTunnel allows the pool to be used for tunnel addresses (IPIP, VXLAN, etc.).
+	IPPoolAllowedUseTunnel IPPoolAllowedUse = "Tunnel"
+	// IPPoolAllowedUseReserved marks the pool as reserved, excluding it from automatic use.
+	IPPoolAllowedUseReserved IPPoolAllowedUse = "Reserved"
+)
@@ -0,0 +1,72 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KubeControllersConfiguration is the Schema for the kube-controllers configuration API.
+//
+// This checkout only carries the part of the real spec the LoadBalancer controller needs
+// (Spec.Controllers.LoadBalancer); the other controller stanzas (Node, Policy, WorkloadEndpoint,
+// ServiceAccount, FederatedServices, ...) aren't modeled here.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KubeControllersConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KubeControllersConfigurationSpec   `json:"spec,omitempty"`
+	Status KubeControllersConfigurationStatus `json:"status,omitempty"`
+}
+
+// KubeControllersConfigurationList contains a list of KubeControllersConfiguration resources.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KubeControllersConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KubeControllersConfiguration `json:"items"`
+}
+
+// KubeControllersConfigurationSpec is the specification of the kube-controllers configuration.
+type KubeControllersConfigurationSpec struct {
+	// Controllers enables and configures the kube-controllers.
+	Controllers ControllersConfig `json:"controllers"`
+}
+
+// ControllersConfig holds one configuration stanza per kube-controller. A nil stanza means the
+// corresponding controller is disabled, the same convention the rest of this field's siblings
+// (not modeled in this checkout) use.
+type ControllersConfig struct {
+	// LoadBalancer configures the LoadBalancer controller, which allocates external IPs for
+	// Services of type LoadBalancer out of Calico IPPools. Nil disables the controller.
+	LoadBalancer *LoadBalancerControllerConfig `json:"loadBalancer,omitempty"`
+}
+
+// LoadBalancerControllerConfig is the configuration for the LoadBalancer controller.
+type LoadBalancerControllerConfig struct {
+	// AssignIPs controls which Services of type LoadBalancer the controller allocates IPs for.
+	// Defaults to RequestedServicesOnly.
+	AssignIPs string `json:"assignIPs,omitempty" validate:"omitempty,oneof=AllServices RequestedServicesOnly"`
+}
+
+// KubeControllersConfigurationStatus represents the status of the kube-controllers configuration.
+type KubeControllersConfigurationStatus struct {
+	// EnvironmentVars contains the environment variables that can be collected from the running
+	// kube-controllers instance.
+	EnvironmentVars map[string]string `json:"environmentVars,omitempty"`
+}
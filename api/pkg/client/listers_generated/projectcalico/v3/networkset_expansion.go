@@ -0,0 +1,22 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3
+
+// NetworkSetNamespaceListerExpansion is the extension point for a namespace-label-aware lookup
+// on NetworkSetNamespaceLister: selector matching for NetworkSets across namespaces is expected
+// to key off the projectcalico.org/namespace label the same way WorkloadEndpoint selector
+// matching already does, rather than the indexer's namespace field that List/Get use. That
+// lookup belongs here once the selector-evaluation code that would call it exists; it isn't
+// implemented yet, so this expansion adds no methods.
@@ -0,0 +1,435 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package loadbalancer implements a controller that allocates external IPs for Services of
+// type LoadBalancer out of Calico IPPools whose AllowedUses includes
+// apiv3.IPPoolAllowedUseLoadBalancer.
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/ipam"
+	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
+)
+
+// annotationLoadBalancerIPs lets a Service request specific external IPs, in addition to (or
+// instead of) spec.loadBalancerIP. It takes a comma separated list of IPv4 and/or IPv6 addresses.
+const annotationLoadBalancerIPs = "projectcalico.org/loadBalancerIPs"
+
+// annotationLoadBalancerIPPools restricts which IPPools auto-assignment is allowed to draw from
+// for a given Service, by name. It takes a comma separated list. If unset, all IPPools that
+// allow the LoadBalancer use are candidates. Names that don't resolve to an existing pool that
+// allows the LoadBalancer use are ignored; if no listed name resolves to a pool of a given IP
+// family, addresses of that family are drawn from all eligible pools.
+const annotationLoadBalancerIPPools = "projectcalico.org/loadBalancerIPPools"
+
+// ipamInterface is the subset of ipam.Interface the controller relies on. It is defined here so
+// that tests can provide a fake implementation.
+type ipamInterface interface {
+	AutoAssign(ctx context.Context, args ipam.AutoAssignArgs) (*ipam.IPAMAssignments, *ipam.IPAMAssignments, error)
+	AssignIP(ctx context.Context, args ipam.AssignIPArgs) error
+	ReleaseByHandle(ctx context.Context, handle string) error
+}
+
+// AssignIPs controls which Services of type LoadBalancer the LoadBalancer kube-controller
+// allocates IPs for.
+type AssignIPs string
+
+const (
+	// AllServices allocates an IP for every Service of type LoadBalancer that does not already
+	// have one.
+	AllServices AssignIPs = "AllServices"
+	// RequestedServicesOnly only allocates IPs for Services that explicitly ask for one via
+	// spec.loadBalancerIP or the projectcalico.org/loadBalancerIPs annotation.
+	RequestedServicesOnly AssignIPs = "RequestedServicesOnly"
+)
+
+// Config is the runtime configuration for the LoadBalancer controller. It is derived from the
+// LoadBalancer stanza of KubeControllersConfigurationSpec.Controllers (apiv3.ControllersConfig) by
+// ConfigFromSpec; callers that build a Controller from a KubeControllersConfiguration resource
+// should go through that rather than constructing a Config by hand.
+type Config struct {
+	// Enabled turns the controller on. Run is a no-op when this is false, so a binary that links
+	// in this controller doesn't allocate addresses unless a cluster has opted in.
+	Enabled bool `json:"enabled,omitempty"`
+	// AssignIPs controls which Services are considered for allocation. Defaults to
+	// RequestedServicesOnly.
+	AssignIPs AssignIPs `json:"assignIPs,omitempty" validate:"omitempty,oneof=AllServices RequestedServicesOnly"`
+}
+
+// ConfigFromSpec builds a Config from the LoadBalancer stanza of a KubeControllersConfiguration's
+// Controllers field. A nil stanza means the cluster hasn't opted into the controller, the same
+// convention its sibling controller stanzas use, and resolves to a disabled Config.
+func ConfigFromSpec(spec *apiv3.LoadBalancerControllerConfig) Config {
+	if spec == nil {
+		return Config{}
+	}
+	cfg := Config{Enabled: true, AssignIPs: RequestedServicesOnly}
+	if spec.AssignIPs != "" {
+		cfg.AssignIPs = AssignIPs(spec.AssignIPs)
+	}
+	return cfg
+}
+
+// Controller watches Services of type LoadBalancer and allocates external IPs for them from
+// Calico IPPools whose AllowedUses includes apiv3.IPPoolAllowedUseLoadBalancer. It also watches
+// IPPools so that a pool being added, changed, or removed is reconsidered for services that are
+// still waiting on an address, or whose pool has become ineligible.
+type Controller struct {
+	cfg          Config
+	k8sClient    kubernetes.Interface
+	ipam         ipamInterface
+	informer     cache.SharedIndexInformer
+	poolInformer cache.SharedIndexInformer
+	queue        workqueue.TypedRateLimitingInterface[string]
+}
+
+// NewController creates a new LoadBalancer controller. poolInformer is the shared informer for
+// Calico IPPools; its cache is used both to resolve named pools from the
+// projectcalico.org/loadBalancerIPPools annotation and to trigger reconciliation of affected
+// Services when a pool changes.
+func NewController(k8sClient kubernetes.Interface, ipamClient ipamInterface, informer, poolInformer cache.SharedIndexInformer, cfg Config) *Controller {
+	c := &Controller{
+		cfg:          cfg,
+		k8sClient:    k8sClient,
+		ipam:         ipamClient,
+		informer:     informer,
+		poolInformer: poolInformer,
+		queue: workqueue.NewTypedRateLimitingQueue[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+		),
+	}
+
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { c.enqueue(obj) },
+		UpdateFunc: func(_, obj interface{}) { c.enqueue(obj) },
+		DeleteFunc: func(obj interface{}) { c.enqueue(obj) },
+	})
+
+	// A pool being added, updated, or deleted can change the outcome for any Service that is
+	// still waiting on an address (or, if it lost the LoadBalancer use, one that already has
+	// one), so re-reconcile everything we know about rather than trying to work out in advance
+	// which Services a given pool affects.
+	poolInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { c.resyncAll() },
+		UpdateFunc: func(interface{}, interface{}) { c.resyncAll() },
+		DeleteFunc: func(interface{}) { c.resyncAll() },
+	})
+
+	return c
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		log.WithError(err).Warn("Failed to build key for LoadBalancer Service, skipping")
+		return
+	}
+	c.queue.Add(key)
+}
+
+func (c *Controller) resyncAll() {
+	for _, key := range c.informer.GetIndexer().ListKeys() {
+		c.queue.Add(key)
+	}
+}
+
+// Run starts the controller and blocks until stopCh is closed.
+func (c *Controller) Run(stopCh chan struct{}) {
+	defer c.queue.ShutDown()
+
+	if !c.cfg.Enabled {
+		log.Info("LoadBalancer controller is disabled, not starting")
+		return
+	}
+
+	log.Info("Starting LoadBalancer controller")
+	if !cache.WaitForNamedCacheSync("loadbalancer", stopCh, c.informer.HasSynced, c.poolInformer.HasSynced) {
+		log.Error("Failed to sync LoadBalancer controller cache")
+		return
+	}
+
+	go wait.Until(c.runWorker, time.Second, stopCh)
+
+	<-stopCh
+	log.Info("Stopping LoadBalancer controller")
+}
+
+func (c *Controller) runWorker() {
+	for c.processNextItem() {
+	}
+}
+
+func (c *Controller) processNextItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.reconcile(key); err != nil {
+		log.WithError(err).Warnf("Failed to reconcile Service %s, retrying", key)
+		c.queue.AddRateLimited(key)
+		return true
+	}
+
+	c.queue.Forget(key)
+	return true
+}
+
+// reconcile allocates or releases external IPs for the Service identified by key, which is of
+// the form <namespace>/<name>.
+func (c *Controller) reconcile(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	handle := ipamHandle(namespace, name)
+
+	obj, exists, err := c.informer.GetIndexer().GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		// The Service was deleted. Release any IPs we allocated for it.
+		return c.ipam.ReleaseByHandle(context.Background(), handle)
+	}
+
+	svc := obj.(*corev1.Service)
+	if svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return nil
+	}
+	if !c.shouldAssign(svc) {
+		return nil
+	}
+	if len(svc.Status.LoadBalancer.Ingress) > 0 {
+		if c.ingressStillEligible(svc) {
+			return nil
+		}
+		// The Service's current address no longer falls in any pool that still allows
+		// LoadBalancer use (the pool lost the use, shrank, or was deleted). Release it and fall
+		// through to reassign, rather than leaving a stale address that's no longer backed by an
+		// eligible pool in place.
+		if err := c.ipam.ReleaseByHandle(context.Background(), handle); err != nil {
+			return fmt.Errorf("failed to release stale IPs for service %s/%s: %w", namespace, name, err)
+		}
+		svc = svc.DeepCopy()
+		svc.Status.LoadBalancer.Ingress = nil
+	}
+
+	attrs := map[string]string{
+		"namespace": namespace,
+		"service":   name,
+	}
+
+	requested := requestedIPs(svc)
+	var ips []string
+	if len(requested) > 0 {
+		for _, ip := range requested {
+			if !c.ipInEligiblePool(ip) {
+				log.Warnf("Requested IP %s for service %s/%s does not fall in any IPPool that allows LoadBalancer use, ignoring", ip, namespace, name)
+				continue
+			}
+			if err := c.ipam.AssignIP(context.Background(), ipam.AssignIPArgs{
+				IP:       ip,
+				HandleID: stringPtr(handle),
+				Attrs:    attrs,
+			}); err != nil {
+				return fmt.Errorf("failed to assign requested IP %s for service %s/%s: %w", ip, namespace, name, err)
+			}
+			ips = append(ips, ip.String())
+		}
+	} else {
+		pools := strings.FieldsFunc(svc.Annotations[annotationLoadBalancerIPPools], func(r rune) bool { return r == ',' })
+		v4, v6, err := c.ipam.AutoAssign(context.Background(), ipam.AutoAssignArgs{
+			Num4:        numRequested(svc, 4),
+			Num6:        numRequested(svc, 6),
+			HandleID:    stringPtr(handle),
+			Attrs:       attrs,
+			IPv4Pools:   c.poolCIDRsByName(pools, 4),
+			IPv6Pools:   c.poolCIDRsByName(pools, 6),
+			IntendedUse: apiv3.IPPoolAllowedUseLoadBalancer,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to assign IP for service %s/%s: %w", namespace, name, err)
+		}
+		for _, ip := range v4.IPs {
+			ips = append(ips, ip.IP.String())
+		}
+		for _, ip := range v6.IPs {
+			ips = append(ips, ip.IP.String())
+		}
+	}
+
+	return c.patchIngress(svc, ips)
+}
+
+// shouldAssign returns true if the given Service is eligible for allocation under the
+// controller's configured AssignIPs mode.
+func (c *Controller) shouldAssign(svc *corev1.Service) bool {
+	if c.cfg.AssignIPs == AllServices {
+		return true
+	}
+	if svc.Spec.LoadBalancerIP != "" {
+		return true
+	}
+	if _, ok := svc.Annotations[annotationLoadBalancerIPs]; ok {
+		return true
+	}
+	return false
+}
+
+// requestedIPs returns the explicit external IPs a Service has asked for via
+// spec.loadBalancerIP and/or the loadBalancerIPs annotation.
+func requestedIPs(svc *corev1.Service) []cnet.IP {
+	var out []cnet.IP
+	if svc.Spec.LoadBalancerIP != "" {
+		if ip := cnet.ParseIP(svc.Spec.LoadBalancerIP); ip != nil {
+			out = append(out, *ip)
+		}
+	}
+	for _, s := range strings.FieldsFunc(svc.Annotations[annotationLoadBalancerIPs], func(r rune) bool { return r == ',' }) {
+		s = strings.TrimSpace(s)
+		if ip := cnet.ParseIP(s); ip != nil {
+			out = append(out, *ip)
+		}
+	}
+	return out
+}
+
+// poolCIDRsByName resolves the given IPPool names to their CIDRs, filtering out pools that don't
+// exist, aren't usable for LoadBalancer allocation, or don't match the requested IP family. An
+// empty names list resolves to no CIDRs, which tells AutoAssign to consider all eligible pools.
+func (c *Controller) poolCIDRsByName(names []string, family int) []cnet.IPNet {
+	var out []cnet.IPNet
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		obj, exists, err := c.poolInformer.GetIndexer().GetByKey(name)
+		if err != nil || !exists {
+			log.Warnf("Requested IPPool %q for LoadBalancer allocation not found, ignoring", name)
+			continue
+		}
+		pool := obj.(*apiv3.IPPool)
+		if !poolAllowsLoadBalancer(pool) {
+			log.Warnf("Requested IPPool %q does not allow LoadBalancer use, ignoring", name)
+			continue
+		}
+		_, cidr, err := cnet.ParseCIDR(pool.Spec.CIDR)
+		if err != nil || cidr == nil {
+			continue
+		}
+		isV4 := cidr.IP.To4() != nil
+		if (family == 4) != isV4 {
+			continue
+		}
+		out = append(out, *cidr)
+	}
+	return out
+}
+
+// poolAllowsLoadBalancer returns true if pool's AllowedUses permits LoadBalancer allocation. A
+// pool with no AllowedUses set gets the default uses (Tunnel, Workload), which don't include
+// LoadBalancer, so it is not eligible.
+func poolAllowsLoadBalancer(pool *apiv3.IPPool) bool {
+	for _, use := range pool.Spec.AllowedUses {
+		if use == apiv3.IPPoolAllowedUseLoadBalancer {
+			return true
+		}
+	}
+	return false
+}
+
+// ingressStillEligible returns true if every address already in svc's status still falls within
+// an IPPool that allows LoadBalancer use. A Service whose address no longer has an eligible pool
+// behind it (the pool was deleted, shrank, or lost the use) is not still eligible.
+func (c *Controller) ingressStillEligible(svc *corev1.Service) bool {
+	for _, ingress := range svc.Status.LoadBalancer.Ingress {
+		ip := cnet.ParseIP(ingress.IP)
+		if ip == nil || !c.ipInEligiblePool(*ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// ipInEligiblePool returns true if ip falls within any known IPPool that allows LoadBalancer use.
+func (c *Controller) ipInEligiblePool(ip cnet.IP) bool {
+	for _, obj := range c.poolInformer.GetIndexer().List() {
+		pool := obj.(*apiv3.IPPool)
+		if !poolAllowsLoadBalancer(pool) {
+			continue
+		}
+		_, cidr, err := cnet.ParseCIDR(pool.Spec.CIDR)
+		if err != nil || cidr == nil {
+			continue
+		}
+		if cidr.Contains(ip.IP) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Controller) patchIngress(svc *corev1.Service, ips []string) error {
+	updated := svc.DeepCopy()
+	updated.Status.LoadBalancer.Ingress = nil
+	for _, ip := range ips {
+		updated.Status.LoadBalancer.Ingress = append(updated.Status.LoadBalancer.Ingress, corev1.LoadBalancerIngress{IP: ip})
+	}
+	_, err := c.k8sClient.CoreV1().Services(svc.Namespace).UpdateStatus(context.Background(), updated, metav1.UpdateOptions{})
+	return err
+}
+
+// numRequested returns how many addresses of the given IP family (4 or 6) svc wants. A Service
+// with no IPFamilies set is treated as requesting a single IPv4 address, matching the Kubernetes
+// default for a Service that hasn't opted into dual-stack. One that does set IPFamilies only gets
+// an address for the families it actually lists.
+func numRequested(svc *corev1.Service, family int) int {
+	if len(svc.Spec.IPFamilies) == 0 {
+		if family == 4 {
+			return 1
+		}
+		return 0
+	}
+	for _, fam := range svc.Spec.IPFamilies {
+		if (family == 4 && fam == corev1.IPv4Protocol) || (family == 6 && fam == corev1.IPv6Protocol) {
+			return 1
+		}
+	}
+	return 0
+}
+
+func ipamHandle(namespace, name string) string {
+	return fmt.Sprintf("loadbalancer-%s-%s", namespace, name)
+}
+
+func stringPtr(s string) *string { return &s }
@@ -0,0 +1,354 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/ipam"
+	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
+)
+
+// fakeIPAM is a test double for ipamInterface. AutoAssign picks addresses from the candidate
+// pools passed to it, simulating exhaustion of any pool whose CIDR is a key in exhausted.
+type fakeIPAM struct {
+	exhausted map[string]bool
+	assigned  map[string]cnet.IP
+	released  []string
+}
+
+func newFakeIPAM() *fakeIPAM {
+	return &fakeIPAM{
+		exhausted: map[string]bool{},
+		assigned:  map[string]cnet.IP{},
+	}
+}
+
+func (f *fakeIPAM) AutoAssign(_ context.Context, args ipam.AutoAssignArgs) (*ipam.IPAMAssignments, *ipam.IPAMAssignments, error) {
+	v4 := &ipam.IPAMAssignments{}
+	v6 := &ipam.IPAMAssignments{}
+
+	if args.Num4 > 0 {
+		ip, err := f.assignFromPools(args.IPv4Pools, "10.0.%d.1")
+		if err != nil {
+			return nil, nil, err
+		}
+		v4.IPs = append(v4.IPs, *ip)
+	}
+	if args.Num6 > 0 {
+		ip, err := f.assignFromPools(args.IPv6Pools, "fd00:%d::1")
+		if err != nil {
+			return nil, nil, err
+		}
+		v6.IPs = append(v6.IPs, *ip)
+	}
+	return v4, v6, nil
+}
+
+// assignFromPools walks the candidate pools in order, as the real IPAM client does, skipping any
+// that are exhausted and falling back to the next one.
+func (f *fakeIPAM) assignFromPools(pools []cnet.IPNet, pattern string) (*cnet.IPNet, error) {
+	if len(pools) == 0 {
+		_, n, _ := cnet.ParseCIDR(fmt.Sprintf(pattern, 0) + "/32")
+		return n, nil
+	}
+	for i, pool := range pools {
+		if f.exhausted[pool.String()] {
+			continue
+		}
+		_, n, _ := cnet.ParseCIDR(fmt.Sprintf(pattern, i) + "/32")
+		return n, nil
+	}
+	return nil, fmt.Errorf("no IPs available in pools %v", pools)
+}
+
+func (f *fakeIPAM) AssignIP(_ context.Context, args ipam.AssignIPArgs) error {
+	f.assigned[*args.HandleID] = args.IP
+	return nil
+}
+
+func (f *fakeIPAM) ReleaseByHandle(_ context.Context, handle string) error {
+	f.released = append(f.released, handle)
+	return nil
+}
+
+func newPool(name, cidr string, uses ...apiv3.IPPoolAllowedUse) *apiv3.IPPool {
+	return &apiv3.IPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: apiv3.IPPoolSpec{
+			CIDR:        cidr,
+			AllowedUses: uses,
+		},
+	}
+}
+
+func newTestController(t *testing.T, ipamClient ipamInterface, svc *corev1.Service, pools ...*apiv3.IPPool) (*Controller, *fake.Clientset) {
+	t.Helper()
+
+	k8sClient := fake.NewSimpleClientset(svc)
+
+	svcInformer := cache.NewSharedIndexInformer(&cache.ListWatch{}, &corev1.Service{}, 0, cache.Indexers{})
+	if err := svcInformer.GetIndexer().Add(svc); err != nil {
+		t.Fatalf("failed to seed service indexer: %v", err)
+	}
+
+	poolInformer := cache.NewSharedIndexInformer(&cache.ListWatch{}, &apiv3.IPPool{}, 0, cache.Indexers{})
+	for _, p := range pools {
+		if err := poolInformer.GetIndexer().Add(p); err != nil {
+			t.Fatalf("failed to seed pool indexer: %v", err)
+		}
+	}
+
+	c := NewController(k8sClient, ipamClient, svcInformer, poolInformer, Config{Enabled: true, AssignIPs: AllServices})
+	return c, k8sClient
+}
+
+func TestReconcileDualStackAutoAssign(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "dual-stack"},
+		Spec: corev1.ServiceSpec{
+			Type:       corev1.ServiceTypeLoadBalancer,
+			IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+		},
+	}
+	pool4 := newPool("pool-v4", "10.0.0.0/16", apiv3.IPPoolAllowedUseLoadBalancer)
+	pool6 := newPool("pool-v6", "fd00::/64", apiv3.IPPoolAllowedUseLoadBalancer)
+
+	ipamClient := newFakeIPAM()
+	c, k8sClient := newTestController(t, ipamClient, svc, pool4, pool6)
+
+	if err := c.reconcile("default/dual-stack"); err != nil {
+		t.Fatalf("reconcile returned error: %v", err)
+	}
+
+	updated, err := k8sClient.CoreV1().Services("default").Get(context.Background(), "dual-stack", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to fetch service: %v", err)
+	}
+	if len(updated.Status.LoadBalancer.Ingress) != 2 {
+		t.Fatalf("expected 2 ingress IPs for dual-stack service, got %d: %v", len(updated.Status.LoadBalancer.Ingress), updated.Status.LoadBalancer.Ingress)
+	}
+}
+
+func TestReconcilePoolExhaustionFallsBackToNextPool(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "fallback",
+			Annotations: map[string]string{annotationLoadBalancerIPPools: "pool-a,pool-b"},
+		},
+		Spec: corev1.ServiceSpec{
+			Type:       corev1.ServiceTypeLoadBalancer,
+			IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+		},
+	}
+	poolA := newPool("pool-a", "10.1.0.0/24", apiv3.IPPoolAllowedUseLoadBalancer)
+	poolB := newPool("pool-b", "10.2.0.0/24", apiv3.IPPoolAllowedUseLoadBalancer)
+
+	ipamClient := newFakeIPAM()
+	_, cidrA, _ := cnet.ParseCIDR(poolA.Spec.CIDR)
+	ipamClient.exhausted[cidrA.String()] = true
+
+	c, k8sClient := newTestController(t, ipamClient, svc, poolA, poolB)
+
+	if err := c.reconcile("default/fallback"); err != nil {
+		t.Fatalf("reconcile returned error: %v", err)
+	}
+
+	updated, err := k8sClient.CoreV1().Services("default").Get(context.Background(), "fallback", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to fetch service: %v", err)
+	}
+	if len(updated.Status.LoadBalancer.Ingress) != 1 {
+		t.Fatalf("expected an ingress IP from the fallback pool, got %v", updated.Status.LoadBalancer.Ingress)
+	}
+}
+
+func TestReconcileHonorsRequestedIP(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "requested"},
+		Spec: corev1.ServiceSpec{
+			Type:           corev1.ServiceTypeLoadBalancer,
+			LoadBalancerIP: "10.5.5.5",
+		},
+	}
+	pool := newPool("pool-a", "10.5.5.0/24", apiv3.IPPoolAllowedUseLoadBalancer)
+
+	ipamClient := newFakeIPAM()
+	c, k8sClient := newTestController(t, ipamClient, svc, pool)
+
+	if err := c.reconcile("default/requested"); err != nil {
+		t.Fatalf("reconcile returned error: %v", err)
+	}
+
+	handle := ipamHandle("default", "requested")
+	got, ok := ipamClient.assigned[handle]
+	if !ok {
+		t.Fatalf("expected AssignIP to be called with handle %q", handle)
+	}
+	if got.String() != "10.5.5.5" {
+		t.Fatalf("expected requested IP 10.5.5.5 to be assigned, got %s", got.String())
+	}
+
+	updated, err := k8sClient.CoreV1().Services("default").Get(context.Background(), "requested", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to fetch service: %v", err)
+	}
+	if len(updated.Status.LoadBalancer.Ingress) != 1 || updated.Status.LoadBalancer.Ingress[0].IP != "10.5.5.5" {
+		t.Fatalf("expected ingress IP 10.5.5.5, got %v", updated.Status.LoadBalancer.Ingress)
+	}
+}
+
+func TestReconcileRejectsRequestedIPOutsideEligiblePool(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "requested"},
+		Spec: corev1.ServiceSpec{
+			Type:           corev1.ServiceTypeLoadBalancer,
+			LoadBalancerIP: "10.5.5.5",
+		},
+	}
+	ipamClient := newFakeIPAM()
+	c, k8sClient := newTestController(t, ipamClient, svc)
+
+	if err := c.reconcile("default/requested"); err != nil {
+		t.Fatalf("reconcile returned error: %v", err)
+	}
+
+	handle := ipamHandle("default", "requested")
+	if _, ok := ipamClient.assigned[handle]; ok {
+		t.Fatalf("expected AssignIP not to be called for an IP outside any eligible pool")
+	}
+
+	updated, err := k8sClient.CoreV1().Services("default").Get(context.Background(), "requested", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to fetch service: %v", err)
+	}
+	if len(updated.Status.LoadBalancer.Ingress) != 0 {
+		t.Fatalf("expected no ingress IP to be assigned, got %v", updated.Status.LoadBalancer.Ingress)
+	}
+}
+
+func TestNumRequestedHonorsExplicitIPFamilies(t *testing.T) {
+	v6Only := &corev1.Service{
+		Spec: corev1.ServiceSpec{IPFamilies: []corev1.IPFamily{corev1.IPv6Protocol}},
+	}
+	if got := numRequested(v6Only, 4); got != 0 {
+		t.Fatalf("expected a v6-only Service to request 0 IPv4 addresses, got %d", got)
+	}
+	if got := numRequested(v6Only, 6); got != 1 {
+		t.Fatalf("expected a v6-only Service to request 1 IPv6 address, got %d", got)
+	}
+
+	noFamilies := &corev1.Service{}
+	if got := numRequested(noFamilies, 4); got != 1 {
+		t.Fatalf("expected a Service with no IPFamilies set to default to 1 IPv4 address, got %d", got)
+	}
+	if got := numRequested(noFamilies, 6); got != 0 {
+		t.Fatalf("expected a Service with no IPFamilies set to request 0 IPv6 addresses, got %d", got)
+	}
+}
+
+func TestConfigFromSpec(t *testing.T) {
+	if got := ConfigFromSpec(nil); got.Enabled {
+		t.Fatalf("expected a nil LoadBalancer stanza to resolve to a disabled Config, got %+v", got)
+	}
+
+	got := ConfigFromSpec(&apiv3.LoadBalancerControllerConfig{})
+	want := Config{Enabled: true, AssignIPs: RequestedServicesOnly}
+	if got != want {
+		t.Fatalf("expected an empty stanza to default AssignIPs to RequestedServicesOnly, got %+v, want %+v", got, want)
+	}
+
+	got = ConfigFromSpec(&apiv3.LoadBalancerControllerConfig{AssignIPs: string(AllServices)})
+	want = Config{Enabled: true, AssignIPs: AllServices}
+	if got != want {
+		t.Fatalf("expected AssignIPs to carry through from the stanza, got %+v, want %+v", got, want)
+	}
+}
+
+func TestReconcileReassignsWhenPoolLosesEligibility(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "stale"},
+		Spec: corev1.ServiceSpec{
+			Type:       corev1.ServiceTypeLoadBalancer,
+			IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+		},
+		Status: corev1.ServiceStatus{
+			LoadBalancer: corev1.LoadBalancerStatus{
+				Ingress: []corev1.LoadBalancerIngress{{IP: "10.3.0.5"}},
+			},
+		},
+	}
+	pool := newPool("pool-a", "10.3.0.0/24", apiv3.IPPoolAllowedUseLoadBalancer)
+
+	ipamClient := newFakeIPAM()
+	c, k8sClient := newTestController(t, ipamClient, svc, pool)
+
+	// The pool no longer allows LoadBalancer use, e.g. an operator narrowed its AllowedUses.
+	narrowed := newPool("pool-a", "10.3.0.0/24", apiv3.IPPoolAllowedUse("Workload"))
+	if err := c.poolInformer.GetIndexer().Update(narrowed); err != nil {
+		t.Fatalf("failed to update pool in indexer: %v", err)
+	}
+
+	if err := c.reconcile("default/stale"); err != nil {
+		t.Fatalf("reconcile returned error: %v", err)
+	}
+
+	handle := ipamHandle("default", "stale")
+	if len(ipamClient.released) != 1 || ipamClient.released[0] != handle {
+		t.Fatalf("expected the stale address to be released via handle %q, got %v", handle, ipamClient.released)
+	}
+
+	updated, err := k8sClient.CoreV1().Services("default").Get(context.Background(), "stale", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to fetch service: %v", err)
+	}
+	if len(updated.Status.LoadBalancer.Ingress) != 1 || updated.Status.LoadBalancer.Ingress[0].IP == "10.3.0.5" {
+		t.Fatalf("expected a freshly assigned ingress IP distinct from the stale one, got %v", updated.Status.LoadBalancer.Ingress)
+	}
+}
+
+func TestReconcileReleasesOnDelete(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "deleted"},
+		Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+	}
+	ipamClient := newFakeIPAM()
+	c, _ := newTestController(t, ipamClient, svc)
+
+	// Remove it from the indexer to simulate the delete having already been observed, the way
+	// the real informer's indexer would look by the time this key is processed.
+	if err := c.informer.GetIndexer().Delete(svc); err != nil {
+		t.Fatalf("failed to remove service from indexer: %v", err)
+	}
+
+	if err := c.reconcile("default/deleted"); err != nil {
+		t.Fatalf("reconcile returned error: %v", err)
+	}
+
+	want := ipamHandle("default", "deleted")
+	if len(ipamClient.released) != 1 || ipamClient.released[0] != want {
+		t.Fatalf("expected ReleaseByHandle(%q), got %v", want, ipamClient.released)
+	}
+}
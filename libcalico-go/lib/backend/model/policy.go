@@ -38,10 +38,32 @@ const PolicyNamePrefixStaged = "staged:"
 
 // PolicyIsStaged returns true if the name of the policy indicates that it is a staged policy.
 func PolicyIsStaged(name string) bool {
-	// Support for staged network policy will be added later
-	return false
+	return strings.HasPrefix(name, PolicyNamePrefixStaged)
 }
 
+// PolicyNameWithoutStagedPrefix strips the staged: prefix from name, if present. It is a no-op
+// for names that are not staged.
+func PolicyNameWithoutStagedPrefix(name string) string {
+	return strings.TrimPrefix(name, PolicyNamePrefixStaged)
+}
+
+// StagedName returns the staged form of name, i.e. name with the staged: prefix added if it is
+// not already present.
+func StagedName(name string) string {
+	if PolicyIsStaged(name) {
+		return name
+	}
+	return PolicyNamePrefixStaged + name
+}
+
+// StagedAction indicates what a staged policy would do to the traffic it matches, were it enforced.
+type StagedAction string
+
+const (
+	StagedActionSet    StagedAction = "Set"
+	StagedActionDelete StagedAction = "Delete"
+)
+
 type PolicyKey struct {
 	Name string `json:"-" validate:"required,name"`
 	Tier string `json:"-" validate:"required,name"`
@@ -93,6 +115,9 @@ func (options PolicyListOptions) defaultPathRoot() string {
 	return k
 }
 
+// KeyFromDefaultPath extracts a PolicyKey from an etcd path built by defaultPath/defaultPathRoot.
+// The captured name segment includes any staged: prefix verbatim, so staged and non-staged
+// policies with the same base name round-trip to distinct keys under distinct paths.
 func (options PolicyListOptions) KeyFromDefaultPath(path string) Key {
 	log.Debugf("Get Policy key from %s", path)
 	r := matchPolicy.FindAllStringSubmatch(path, -1)
@@ -125,6 +150,7 @@ type Policy struct {
 	ApplyOnForward   bool                          `json:"apply_on_forward,omitempty"`
 	Types            []string                      `json:"types,omitempty"`
 	PerformanceHints []apiv3.PolicyPerformanceHint `json:"performance_hints,omitempty" validate:"omitempty,unique,dive,oneof=AssumeNeededOnEveryNode"`
+	StagedAction     StagedAction                  `json:"staged_action,omitempty" validate:"omitempty,oneof=Set Delete"`
 }
 
 func (p Policy) String() string {
@@ -150,5 +176,8 @@ func (p Policy) String() string {
 	if len(p.PerformanceHints) > 0 {
 		parts = append(parts, fmt.Sprintf("performance_hints:%v", p.PerformanceHints))
 	}
+	if p.StagedAction != "" {
+		parts = append(parts, fmt.Sprintf("staged_action:%v", p.StagedAction))
+	}
 	return strings.Join(parts, ",")
 }
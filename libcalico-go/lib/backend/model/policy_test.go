@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "testing"
+
+func TestPolicyIsStaged(t *testing.T) {
+	tests := []struct {
+		name   string
+		staged bool
+	}{
+		{"default.foo", false},
+		{StagedName("default.foo"), true},
+		{"staged:default.foo", true},
+	}
+	for _, tt := range tests {
+		if got := PolicyIsStaged(tt.name); got != tt.staged {
+			t.Errorf("PolicyIsStaged(%q) = %v, want %v", tt.name, got, tt.staged)
+		}
+	}
+}
+
+func TestStagedNameAndWithoutPrefixRoundTrip(t *testing.T) {
+	const base = "default.foo"
+	staged := StagedName(base)
+	if staged != PolicyNamePrefixStaged+base {
+		t.Fatalf("StagedName(%q) = %q, want %q", base, staged, PolicyNamePrefixStaged+base)
+	}
+	if got := PolicyNameWithoutStagedPrefix(staged); got != base {
+		t.Fatalf("PolicyNameWithoutStagedPrefix(%q) = %q, want %q", staged, got, base)
+	}
+	// StagedName is idempotent.
+	if got := StagedName(staged); got != staged {
+		t.Fatalf("StagedName(%q) = %q, want %q (no double prefix)", staged, got, staged)
+	}
+	// Non-staged names are untouched by the stripping helper.
+	if got := PolicyNameWithoutStagedPrefix(base); got != base {
+		t.Fatalf("PolicyNameWithoutStagedPrefix(%q) = %q, want %q", base, got, base)
+	}
+}
+
+// TestPolicyKeyPathRoundTripsStagedNames asserts that a staged policy and its non-staged
+// counterpart of the same base name map to distinct etcd paths, and that KeyFromDefaultPath
+// reconstructs the original name, staged prefix included, from either path.
+func TestPolicyKeyPathRoundTripsStagedNames(t *testing.T) {
+	names := []string{"default.foo", StagedName("default.foo")}
+
+	paths := make([]string, len(names))
+	for i, name := range names {
+		key := PolicyKey{Tier: "default", Name: name}
+		path, err := key.defaultPath()
+		if err != nil {
+			t.Fatalf("defaultPath() for %q returned error: %v", name, err)
+		}
+		paths[i] = path
+
+		got := PolicyListOptions{}.KeyFromDefaultPath(path)
+		pk, ok := got.(PolicyKey)
+		if !ok {
+			t.Fatalf("KeyFromDefaultPath(%q) = %#v, want a PolicyKey", path, got)
+		}
+		if pk.Name != name || pk.Tier != "default" {
+			t.Fatalf("KeyFromDefaultPath(%q) = %#v, want Tier=default Name=%q", path, pk, name)
+		}
+	}
+
+	if paths[0] == paths[1] {
+		t.Fatalf("staged and non-staged policies with the same base name collided on path %q", paths[0])
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cachedlister provides a TTL-based cache in front of a generated, cluster-scoped
+// lister, for controllers that call Lister().List(selector) on hot paths and only need an
+// approximately up to date view.
+package cachedlister
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// ClusterLister is satisfied by the cluster-scoped listers generated into
+// github.com/projectcalico/api/pkg/client/listers_generated/projectcalico/v3, such as
+// GlobalNetworkSetLister and IPReservationLister.
+type ClusterLister[T any] interface {
+	List(selector labels.Selector) (ret []*T, err error)
+	Get(name string) (*T, error)
+}
+
+// cachedSelectorResult is the memoized outcome of a single List(selector) call.
+type cachedSelectorResult[T any] struct {
+	items     []*T
+	err       error
+	refreshed time.Time
+}
+
+// CachedLister wraps a cluster-scoped lister and serves List(selector) out of a TTL-based cache,
+// refreshing a given selector's result only once expiration has elapsed since it was last
+// fetched. It is a drop-in replacement for the lister it wraps: it implements the same
+// List/Get method set, so it satisfies interfaces such as GlobalNetworkSetLister and
+// IPReservationLister. This mirrors the CachedServerCounter pattern used by
+// apiserver-network-proxy, adapted to the List/Get shape of generated listers.
+//
+// CachedLister is intended for hot paths that only need an approximately up to date view, e.g.
+// policy processing that calls Lister().List(selector) on every packet processing cycle. It is
+// not a substitute for the informer's own cache consistency guarantees.
+type CachedLister[T any] struct {
+	source     ClusterLister[T]
+	expiration time.Duration
+
+	mu         sync.RWMutex
+	bySelector map[string]cachedSelectorResult[T]
+
+	hits          prometheus.Counter
+	misses        prometheus.Counter
+	refreshErrors prometheus.Counter
+}
+
+// NewCachedLister returns a CachedLister that serves List(selector) calls against source out of
+// a cache, refreshing a selector's entry once expiration has elapsed since it was last fetched.
+// resourceName is used to namespace the exposed Prometheus counters, e.g. "globalnetworkset".
+func NewCachedLister[T any](source ClusterLister[T], expiration time.Duration, resourceName string) *CachedLister[T] {
+	return &CachedLister[T]{
+		source:     source,
+		expiration: expiration,
+		bySelector: make(map[string]cachedSelectorResult[T]),
+		hits: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("calico_cached_lister_%s_hits_total", resourceName),
+			Help: fmt.Sprintf("Number of List() calls for %s served from the cache.", resourceName),
+		}),
+		misses: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("calico_cached_lister_%s_misses_total", resourceName),
+			Help: fmt.Sprintf("Number of List() calls for %s that required a refresh from the underlying lister.", resourceName),
+		}),
+		refreshErrors: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: fmt.Sprintf("calico_cached_lister_%s_refresh_errors_total", resourceName),
+			Help: fmt.Sprintf("Number of failed refreshes of the %s cache from the underlying lister.", resourceName),
+		}),
+	}
+}
+
+// Collectors returns the Prometheus collectors backing this cache's metrics, for callers that
+// want to register them with a registry.
+func (c *CachedLister[T]) Collectors() []prometheus.Collector {
+	return []prometheus.Collector{c.hits, c.misses, c.refreshErrors}
+}
+
+// List returns the cached result for selector if it was refreshed within the configured
+// expiration, otherwise it refreshes from the underlying lister and caches the new result.
+func (c *CachedLister[T]) List(selector labels.Selector) (ret []*T, err error) {
+	key := selector.String()
+
+	c.mu.RLock()
+	cached, ok := c.bySelector[key]
+	c.mu.RUnlock()
+	if ok && time.Since(cached.refreshed) < c.expiration {
+		c.hits.Inc()
+		return cached.items, cached.err
+	}
+
+	c.misses.Inc()
+	items, err := c.source.List(selector)
+	if err != nil {
+		c.refreshErrors.Inc()
+	}
+
+	c.mu.Lock()
+	c.bySelector[key] = cachedSelectorResult[T]{items: items, err: err, refreshed: time.Now()}
+	c.evictExpiredLocked()
+	c.mu.Unlock()
+
+	return items, err
+}
+
+// evictExpiredLocked drops entries that haven't been refreshed within expiration. Without this, a
+// caller that builds selectors dynamically (e.g. one selector per namespace or per policy) would
+// grow bySelector without bound between Invalidate calls, since a miss only ever adds an entry and
+// nothing previously removed one. c.mu must be held for writing.
+func (c *CachedLister[T]) evictExpiredLocked() {
+	now := time.Now()
+	for key, cached := range c.bySelector {
+		if now.Sub(cached.refreshed) >= c.expiration {
+			delete(c.bySelector, key)
+		}
+	}
+}
+
+// Get always goes straight to the underlying lister. Callers on the Get path are typically
+// looking up a single, specific object by name rather than scanning the whole set, so the TTL
+// cache that List benefits from doesn't apply here.
+func (c *CachedLister[T]) Get(name string) (*T, error) {
+	return c.source.Get(name)
+}
+
+// Invalidate drops all cached selector results, forcing the next List call for any selector to
+// refresh from the underlying lister. Informer event handlers should call this on add, update,
+// and delete so that a cached read after a change reflects it immediately rather than waiting
+// out the TTL.
+func (c *CachedLister[T]) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.bySelector = make(map[string]cachedSelectorResult[T])
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cachedlister
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// thing is a stand-in for a generated resource type, e.g. GlobalNetworkSet.
+type thing struct {
+	Name string
+}
+
+// fakeSource counts List/Get calls, so tests can tell whether a List call actually reached it or
+// was served from cache. List always returns the same single item.
+type fakeSource struct {
+	listCalls int
+	getCalls  int
+}
+
+func (f *fakeSource) List(_ labels.Selector) ([]*thing, error) {
+	f.listCalls++
+	return []*thing{{Name: "gen"}}, nil
+}
+
+func (f *fakeSource) Get(name string) (*thing, error) {
+	f.getCalls++
+	return &thing{Name: name}, nil
+}
+
+func TestCachedListerServesStaleReadsWithinTTL(t *testing.T) {
+	source := &fakeSource{}
+	cl := NewCachedLister[thing](source, time.Hour, "thing")
+
+	if _, err := cl.List(labels.Everything()); err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if _, err := cl.List(labels.Everything()); err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+
+	if source.listCalls != 1 {
+		t.Fatalf("expected 1 refresh from the underlying lister within the TTL, got %d", source.listCalls)
+	}
+}
+
+func TestCachedListerRefreshesAfterExpiration(t *testing.T) {
+	source := &fakeSource{}
+	cl := NewCachedLister[thing](source, 10*time.Millisecond, "thing")
+
+	if _, err := cl.List(labels.Everything()); err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	if _, err := cl.List(labels.Everything()); err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+
+	if source.listCalls != 2 {
+		t.Fatalf("expected a fresh read after expiration, got %d calls to the underlying lister", source.listCalls)
+	}
+}
+
+func TestCachedListerRefreshesAfterInvalidate(t *testing.T) {
+	source := &fakeSource{}
+	cl := NewCachedLister[thing](source, time.Hour, "thing")
+
+	if _, err := cl.List(labels.Everything()); err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+
+	cl.Invalidate()
+
+	if _, err := cl.List(labels.Everything()); err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+
+	if source.listCalls != 2 {
+		t.Fatalf("expected Invalidate to force a fresh read, got %d calls to the underlying lister", source.listCalls)
+	}
+}
+
+func TestCachedListerCachesPerSelector(t *testing.T) {
+	source := &fakeSource{}
+	cl := NewCachedLister[thing](source, time.Hour, "thing")
+
+	sel1, err := labels.Parse("app=a")
+	if err != nil {
+		t.Fatalf("failed to parse selector: %v", err)
+	}
+	sel2, err := labels.Parse("app=b")
+	if err != nil {
+		t.Fatalf("failed to parse selector: %v", err)
+	}
+
+	if _, err := cl.List(sel1); err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if _, err := cl.List(sel2); err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if _, err := cl.List(sel1); err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+
+	if source.listCalls != 2 {
+		t.Fatalf("expected one refresh per distinct selector, got %d calls to the underlying lister", source.listCalls)
+	}
+}
+
+func TestCachedListerEvictsExpiredSelectors(t *testing.T) {
+	source := &fakeSource{}
+	cl := NewCachedLister[thing](source, 10*time.Millisecond, "thing")
+
+	sel1, err := labels.Parse("app=a")
+	if err != nil {
+		t.Fatalf("failed to parse selector: %v", err)
+	}
+	sel2, err := labels.Parse("app=b")
+	if err != nil {
+		t.Fatalf("failed to parse selector: %v", err)
+	}
+
+	if _, err := cl.List(sel1); err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	// sel1's entry is now past its TTL and, since nothing re-queries it, is never overwritten.
+	// Querying a different selector should sweep it out rather than leaving it to accumulate.
+	if _, err := cl.List(sel2); err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+
+	if got := len(cl.bySelector); got != 1 {
+		t.Fatalf("expected the expired sel1 entry to be evicted, leaving 1 entry, got %d", got)
+	}
+	if _, ok := cl.bySelector[sel2.String()]; !ok {
+		t.Fatalf("expected sel2's fresh entry to remain cached")
+	}
+}
+
+func TestCachedListerGetBypassesCache(t *testing.T) {
+	source := &fakeSource{}
+	cl := NewCachedLister[thing](source, time.Hour, "thing")
+
+	if _, err := cl.Get("foo"); err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if _, err := cl.Get("foo"); err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+
+	if source.getCalls != 2 {
+		t.Fatalf("expected Get to always go to the underlying lister, got %d calls", source.getCalls)
+	}
+}